@@ -0,0 +1,314 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"text/template"
+
+	"github.com/jroimartin/gocui"
+)
+
+// commandMenuOpen/commandMenuItems/commandMenuApp drive the "command_menu"
+// modal opened by "c" (per-app custom commands) and "b" (bulk commands).
+// commandMenuApp is nil for a bulk menu, in which case the selected
+// command runs once per application instead of against a single one.
+var commandMenuOpen bool
+var commandMenuCursor int
+var commandMenuItems []Command
+var commandMenuApp *Application
+
+// openCommandMenu shows the selected app's custom commands, falling back
+// to the global bulk commands if it has none of its own. The menu still
+// opens when there's nothing to show yet, since "n" from inside it is how
+// the first command gets added.
+func openCommandMenu(g *gocui.Gui, v *gocui.View) error {
+	app := getAppFromCursor(v)
+	if app == nil {
+		return nil
+	}
+	items := app.CustomCommands
+	if len(items) == 0 {
+		items = bulkCommands
+	}
+	commandMenuApp = app
+	commandMenuItems = items
+	commandMenuCursor = 0
+	commandMenuOpen = true
+	return nil
+}
+
+// openBulkMenu shows the global bulk commands, run against every app.
+func openBulkMenu(g *gocui.Gui, v *gocui.View) error {
+	commandMenuApp = nil
+	commandMenuItems = bulkCommands
+	commandMenuCursor = 0
+	commandMenuOpen = true
+	return nil
+}
+
+// closeCommandMenu hides the command menu without running anything.
+func closeCommandMenu(g *gocui.Gui, v *gocui.View) error {
+	commandMenuOpen = false
+	return nil
+}
+
+func commandMenuCursorDown(g *gocui.Gui, v *gocui.View) error {
+	if commandMenuCursor < len(commandMenuItems)-1 {
+		commandMenuCursor++
+	}
+	return nil
+}
+
+func commandMenuCursorUp(g *gocui.Gui, v *gocui.View) error {
+	if commandMenuCursor > 0 {
+		commandMenuCursor--
+	}
+	return nil
+}
+
+// runSelectedCommand runs the highlighted command, gating on a
+// confirmation panel first when the command asks for one.
+func runSelectedCommand(g *gocui.Gui, v *gocui.View) error {
+	if commandMenuCursor < 0 || commandMenuCursor >= len(commandMenuItems) {
+		commandMenuOpen = false
+		return nil
+	}
+	cmd := commandMenuItems[commandMenuCursor]
+	app := commandMenuApp
+	commandMenuOpen = false
+
+	run := func(gg *gocui.Gui) error {
+		return executeCommand(gg, cmd, app)
+	}
+	if cmd.Confirm {
+		// Remove the menu now: layout would otherwise delete it on the next
+		// frame and move focus to the list, away from the confirmation.
+		if _, err := g.View("command_menu"); err == nil {
+			if err := g.DeleteView("command_menu"); err != nil {
+				return err
+			}
+		}
+		return createConfirmationPanel(g, "Confirm", fmt.Sprintf("Run %q?", cmd.Name), run, nil)
+	}
+	return run(g)
+}
+
+// renderCommandMenu creates (if needed) and redraws the centered command
+// menu modal.
+func renderCommandMenu(g *gocui.Gui) error {
+	maxX, maxY := g.Size()
+	width := 50
+	if width > maxX-4 {
+		width = maxX - 4
+	}
+	height := len(commandMenuItems) + 2
+	if height > maxY-4 {
+		height = maxY - 4
+	}
+	left := (maxX - width) / 2
+	top := (maxY - height) / 2
+
+	title := "Bulk Commands (n: new)"
+	if commandMenuApp != nil {
+		title = fmt.Sprintf("Commands • %s (n: new)", commandMenuApp.Name)
+	}
+
+	mv, err := g.SetView("command_menu", left, top, left+width, top+height)
+	if err != nil && err != gocui.ErrUnknownView {
+		return err
+	}
+	mv.Title = title
+	mv.Highlight = true
+	mv.SelBgColor = gocui.ColorGreen
+	mv.SelFgColor = gocui.ColorBlack
+	mv.Clear()
+	for _, cmd := range commandMenuItems {
+		fmt.Fprintln(mv, cmd.Name)
+	}
+	if err := mv.SetCursor(0, commandMenuCursor); err != nil {
+		return err
+	}
+	if _, err := g.View("new_command_modal"); err == nil {
+		// The new-command modal is open on top and keeps focus.
+		return nil
+	}
+	_, err = g.SetCurrentView("command_menu")
+	return err
+}
+
+// executeCommand runs cmd against app, or against every application when
+// app is nil (a bulk command). Bulk commands always go through the task
+// manager rather than the teardown launcher, since tearing down the UI
+// once per app would only ever run the first one.
+func executeCommand(g *gocui.Gui, cmd Command, app *Application) error {
+	if app != nil {
+		return runRenderedCommand(g, cmd, app, cmd.Attach)
+	}
+	for i := range applications {
+		if err := runRenderedCommand(g, cmd, &applications[i], false); err != nil {
+			log.Println("Error running bulk command:", err)
+		}
+	}
+	return nil
+}
+
+// runRenderedCommand renders cmd.Command as a template against app and
+// runs it: through the task manager (attach=false) or the exclusive
+// teardown launcher (attach=true), exactly like runApp does for a regular
+// Application.
+func runRenderedCommand(g *gocui.Gui, cmd Command, app *Application, attach bool) error {
+	rendered, err := renderCommandTemplate(cmd.Command, app)
+	if err != nil {
+		log.Println("Error rendering command template:", err)
+		return nil
+	}
+
+	adhoc := &Application{Name: cmd.Name, Command: rendered, Exclusive: attach}
+	if adhoc.Exclusive {
+		pendingApp = adhoc
+		return ErrRestart
+	}
+	taskManager.Start(g, adhoc)
+	return nil
+}
+
+// renderCommandTemplate substitutes an Application's fields (e.g.
+// "which {{.Command}}") into a custom/bulk command's Command template,
+// mirroring lazydocker's custom command templating.
+func renderCommandTemplate(tmpl string, app *Application) (string, error) {
+	t, err := template.New("command").Parse(tmpl)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, app); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// openNewCommandModal shows a centered modal with name/command inputs for
+// adding a command to whatever commandMenuApp/commandMenuItems the command
+// menu is currently showing, mirroring openAddModal.
+func openNewCommandModal(g *gocui.Gui, v *gocui.View) error {
+	maxX, maxY := g.Size()
+	width := 64
+	height := 12
+	if width > maxX-4 {
+		width = maxX - 4
+	}
+	if height > maxY-4 {
+		height = maxY - 4
+	}
+	left := (maxX - width) / 2
+	top := (maxY - height) / 2
+	right := left + width
+	bottom := top + height
+
+	title := "New Bulk Command"
+	if commandMenuApp != nil {
+		title = fmt.Sprintf("New Command • %s", commandMenuApp.Name)
+	}
+
+	if mv, err := g.SetView("new_command_modal", left, top, right, bottom); err != nil {
+		if err != gocui.ErrUnknownView {
+			return err
+		}
+		mv.Title = title
+		mv.Wrap = false
+		fmt.Fprintln(mv, "Command is a Go template run against the app, e.g. \"which {{.Command}}\".")
+		fmt.Fprintln(mv, "Press Enter on Command to save. Esc cancels.")
+	}
+	// Name field (height 3 => 1 inner text line with frame)
+	if nv, err := g.SetView("new_command_name", left+2, top+3, right-2, top+5); err != nil {
+		if err != gocui.ErrUnknownView {
+			return err
+		}
+		nv.Title = "Name"
+		nv.Editable = true
+		nv.Editor = gocui.DefaultEditor
+	}
+	// Command field (height 3 => 1 inner text line with frame)
+	if cv, err := g.SetView("new_command_cmd", left+2, top+8, right-2, top+10); err != nil {
+		if err != gocui.ErrUnknownView {
+			return err
+		}
+		cv.Title = "Command"
+		cv.Editable = true
+		cv.Editor = gocui.DefaultEditor
+	}
+
+	if _, err := g.SetCurrentView("new_command_name"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// switchCommandField moves focus between the new-command name and command
+// inputs, mirroring switchAddField.
+func switchCommandField(g *gocui.Gui, v *gocui.View) error {
+	if v == nil {
+		return nil
+	}
+	if v.Name() == "new_command_name" {
+		_, err := g.SetCurrentView("new_command_cmd")
+		return err
+	}
+	_, err := g.SetCurrentView("new_command_name")
+	return err
+}
+
+// saveNewCommand reads the new-command fields, appends the command to the
+// app's CustomCommands (or the global BulkCommands for a bulk menu), writes
+// it back via writeConfig and refreshes the command menu to show it.
+func saveNewCommand(g *gocui.Gui, v *gocui.View) error {
+	nameV, err := g.View("new_command_name")
+	if err != nil {
+		return err
+	}
+	cmdV, err := g.View("new_command_cmd")
+	if err != nil {
+		return err
+	}
+	name := trimViewText(nameV)
+	cmd := trimViewText(cmdV)
+	if name == "" || cmd == "" {
+		return nil
+	}
+	newCmd := Command{Name: name, Command: cmd}
+
+	if commandMenuApp != nil {
+		for i := range applications {
+			if applications[i].Name == commandMenuApp.Name && applications[i].Command == commandMenuApp.Command {
+				applications[i].CustomCommands = append(applications[i].CustomCommands, newCmd)
+				commandMenuApp = &applications[i]
+				break
+			}
+		}
+		commandMenuItems = commandMenuApp.CustomCommands
+	} else {
+		bulkCommands = append(bulkCommands, newCmd)
+		commandMenuItems = bulkCommands
+	}
+	commandMenuCursor = len(commandMenuItems) - 1
+
+	if err := writeConfig(activeProfilePath(), Config{Applications: applications, Gui: guiConfig, BulkCommands: bulkCommands}); err != nil {
+		log.Println("Error writing config:", err)
+	}
+
+	cancelNewCommandModal(g, nil)
+	return nil
+}
+
+// cancelNewCommandModal removes the new-command modal and its input views,
+// returning focus to the command menu.
+func cancelNewCommandModal(g *gocui.Gui, v *gocui.View) error {
+	for _, name := range []string{"new_command_modal", "new_command_name", "new_command_cmd"} {
+		if cv, err := g.View(name); err == nil {
+			g.DeleteView(cv.Name())
+		}
+	}
+	_, err := g.SetCurrentView("command_menu")
+	return err
+}
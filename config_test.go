@@ -0,0 +1,134 @@
+package main
+
+import "testing"
+
+func TestComputeSidebarWidthDefaultRatio(t *testing.T) {
+	got := computeSidebarWidth(120, 0.333)
+	if got != 39 {
+		t.Fatalf("expected 39, got %d", got)
+	}
+}
+
+func TestComputeSidebarWidthClampsToMinimum(t *testing.T) {
+	got := computeSidebarWidth(40, 0.1)
+	if got != 24 {
+		t.Fatalf("expected clamped minimum of 24, got %d", got)
+	}
+}
+
+func TestComputeSidebarWidthClampsForDashboardRoom(t *testing.T) {
+	got := computeSidebarWidth(80, 0.9)
+	if got != 50 {
+		t.Fatalf("expected clamp to maxX-30=50, got %d", got)
+	}
+}
+
+func TestComputePanelBoundsNoAccordion(t *testing.T) {
+	cfg := GuiConfig{ShowBottomLine: true, ExpandFocusedSidePanel: false}
+	listBottom, dashBottom := computePanelBounds(40, cfg, "list")
+	if listBottom != 38 || dashBottom != 38 {
+		t.Fatalf("expected both bounds at 38, got list=%d dash=%d", listBottom, dashBottom)
+	}
+}
+
+func TestComputePanelBoundsAccordionExpandsFocusedDashboard(t *testing.T) {
+	cfg := GuiConfig{ShowBottomLine: true, ExpandFocusedSidePanel: true}
+	listBottom, dashBottom := computePanelBounds(40, cfg, "dashboard")
+	if dashBottom != 38 {
+		t.Fatalf("expected focused dashboard to keep full height, got %d", dashBottom)
+	}
+	if listBottom != 34 {
+		t.Fatalf("expected unfocused list to shrink by 4, got %d", listBottom)
+	}
+}
+
+func TestComputePanelBoundsAccordionExpandsFocusedList(t *testing.T) {
+	cfg := GuiConfig{ShowBottomLine: true, ExpandFocusedSidePanel: true}
+	listBottom, dashBottom := computePanelBounds(40, cfg, "list")
+	if listBottom != 38 {
+		t.Fatalf("expected focused list to keep full height, got %d", listBottom)
+	}
+	if dashBottom != 34 {
+		t.Fatalf("expected unfocused dashboard to shrink by 4, got %d", dashBottom)
+	}
+}
+
+func TestComputePanelBoundsWithoutBottomLine(t *testing.T) {
+	cfg := GuiConfig{ShowBottomLine: false}
+	listBottom, dashBottom := computePanelBounds(40, cfg, "list")
+	if listBottom != 39 || dashBottom != 39 {
+		t.Fatalf("expected both bounds at 39 with footer hidden, got list=%d dash=%d", listBottom, dashBottom)
+	}
+}
+
+func TestBottomStripBoundsWithFooter(t *testing.T) {
+	top, bottom := bottomStripBounds(40, GuiConfig{ShowBottomLine: true})
+	if top != 38 || bottom != 39 {
+		t.Fatalf("expected strip at 38-39, got %d-%d", top, bottom)
+	}
+}
+
+func TestBottomStripBoundsWithoutFooter(t *testing.T) {
+	top, bottom := bottomStripBounds(40, GuiConfig{ShowBottomLine: false})
+	if top != 39 || bottom != 40 {
+		t.Fatalf("expected strip at 39-40 with footer hidden, got %d-%d", top, bottom)
+	}
+}
+
+func TestApplyGuiDefaultsFillsZeroValue(t *testing.T) {
+	config := Config{}
+	applyGuiDefaults(&config)
+	if config.Gui.SidePanelWidth != 0.333 {
+		t.Fatalf("expected default sidePanelWidth, got %+v", config.Gui)
+	}
+}
+
+func TestApplyGuiDefaultsLeavesExplicitValues(t *testing.T) {
+	config := Config{Gui: GuiConfig{SidePanelWidth: 0.5, ExpandFocusedSidePanel: true}}
+	applyGuiDefaults(&config)
+	if config.Gui.SidePanelWidth != 0.5 || !config.Gui.ExpandFocusedSidePanel {
+		t.Fatalf("expected explicit gui config preserved, got %+v", config.Gui)
+	}
+}
+
+func TestSanitizeAppNamePassesThroughPlainName(t *testing.T) {
+	if got := sanitizeAppName("LazyGit"); got != "LazyGit" {
+		t.Fatalf("expected plain name unchanged, got %q", got)
+	}
+}
+
+func TestSanitizeAppNameStripsPathTraversal(t *testing.T) {
+	if got := sanitizeAppName("../../../.ssh/authorized_keys"); got != "authorized_keys" {
+		t.Fatalf("expected traversal stripped to base name, got %q", got)
+	}
+}
+
+func TestSanitizeAppNameStripsLeadingDots(t *testing.T) {
+	if got := sanitizeAppName("..hidden"); got != "hidden" {
+		t.Fatalf("expected leading dots stripped, got %q", got)
+	}
+}
+
+func TestSanitizeAppNameFallsBackForEmptyResult(t *testing.T) {
+	if got := sanitizeAppName(""); got != "app" {
+		t.Fatalf("expected fallback name for empty input, got %q", got)
+	}
+	if got := sanitizeAppName("."); got != "app" {
+		t.Fatalf("expected fallback name for all-dots input, got %q", got)
+	}
+}
+
+func TestApplyGuiDefaultsLeavesExplicitFalseAlongsideMissingField(t *testing.T) {
+	// sidePanelWidth omitted (so it should still default), but
+	// showBottomLine was explicitly set to false and must survive -
+	// regression test for applyGuiDefaults resetting the whole Gui block
+	// whenever SidePanelWidth alone was zero.
+	config := Config{Gui: GuiConfig{ShowBottomLine: false}}
+	applyGuiDefaults(&config)
+	if config.Gui.SidePanelWidth != 0.333 {
+		t.Fatalf("expected sidePanelWidth to default to 0.333, got %v", config.Gui.SidePanelWidth)
+	}
+	if config.Gui.ShowBottomLine {
+		t.Fatalf("expected explicit showBottomLine=false to survive defaulting, got true")
+	}
+}
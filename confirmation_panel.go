@@ -0,0 +1,81 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/jroimartin/gocui"
+)
+
+// confirmationOnConfirm/onCancel are stashed here rather than captured in
+// a fresh closure per view, because the "confirmation" view and its
+// keybindings are created once and reused for every caller (command menu
+// today, anything else later) - modeled on lazygit's confirmation_panel.go.
+var confirmationOnConfirm func(g *gocui.Gui) error
+var confirmationOnCancel func(g *gocui.Gui) error
+
+// createConfirmationPanel opens a centered Yes/No modal showing prompt
+// under title. Enter runs onConfirm, Esc runs onCancel (either may be nil).
+func createConfirmationPanel(g *gocui.Gui, title, prompt string, onConfirm, onCancel func(g *gocui.Gui) error) error {
+	confirmationOnConfirm = onConfirm
+	confirmationOnCancel = onCancel
+
+	maxX, maxY := g.Size()
+	width := len(prompt) + 6
+	if width < 30 {
+		width = 30
+	}
+	if width > maxX-4 {
+		width = maxX - 4
+	}
+	height := 4
+	left := (maxX - width) / 2
+	top := (maxY - height) / 2
+
+	cv, err := g.SetView("confirmation", left, top, left+width, top+height)
+	if err != nil && err != gocui.ErrUnknownView {
+		return err
+	}
+	cv.Title = title
+	cv.Clear()
+	fmt.Fprintf(cv, "%s\n\n[Enter] Yes   [Esc] No", prompt)
+
+	_, err = g.SetCurrentView("confirmation")
+	return err
+}
+
+// confirmConfirmation is the "confirmation" view's Enter handler.
+func confirmConfirmation(g *gocui.Gui, v *gocui.View) error {
+	onConfirm := confirmationOnConfirm
+	if err := closeConfirmationPanel(g); err != nil {
+		return err
+	}
+	if onConfirm != nil {
+		return onConfirm(g)
+	}
+	return nil
+}
+
+// cancelConfirmation is the "confirmation" view's Esc handler.
+func cancelConfirmation(g *gocui.Gui, v *gocui.View) error {
+	onCancel := confirmationOnCancel
+	if err := closeConfirmationPanel(g); err != nil {
+		return err
+	}
+	if onCancel != nil {
+		return onCancel(g)
+	}
+	return nil
+}
+
+// closeConfirmationPanel removes the modal and returns focus to the list.
+func closeConfirmationPanel(g *gocui.Gui) error {
+	confirmationOnConfirm = nil
+	confirmationOnCancel = nil
+	if _, err := g.View("confirmation"); err == nil {
+		if err := g.DeleteView("confirmation"); err != nil {
+			return err
+		}
+	}
+	_, err := g.SetCurrentView("list")
+	return err
+}
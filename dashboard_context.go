@@ -0,0 +1,272 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/jroimartin/gocui"
+)
+
+// DashboardContext renders one tab's worth of content into the dashboard
+// view for the currently selected application, mirroring lazydocker's
+// per-service contexts (logs, stats, env, config, top).
+type DashboardContext interface {
+	Title() string
+	Render(dv *gocui.View, app *Application)
+}
+
+// dashboardContexts is the ordered set of tabs available on the dashboard.
+// "[" and "]" cycle through this slice.
+var dashboardContexts = []DashboardContext{
+	overviewContext{},
+	envContext{},
+	helpContext{},
+	recentContext{},
+}
+
+// appContextIndex remembers which dashboard tab was last viewed for each
+// application (keyed by name), so switching selection away and back
+// restores it rather than resetting to Overview.
+var appContextIndex = map[string]int{}
+
+// activeContextFor returns the DashboardContext currently selected for app,
+// defaulting to the first (Overview) one.
+func activeContextFor(app *Application) DashboardContext {
+	if app == nil {
+		return dashboardContexts[0]
+	}
+	idx := appContextIndex[app.Name]
+	if idx < 0 || idx >= len(dashboardContexts) {
+		idx = 0
+	}
+	return dashboardContexts[idx]
+}
+
+// cycleDashboardContext moves the selected application's active context by
+// delta (wrapping both ways), backing the "[" and "]" keybindings.
+func cycleDashboardContext(delta int) func(g *gocui.Gui, v *gocui.View) error {
+	return func(g *gocui.Gui, v *gocui.View) error {
+		app := currentSelectedApp(g)
+		if app == nil {
+			return nil
+		}
+		n := len(dashboardContexts)
+		idx := ((appContextIndex[app.Name]+delta)%n + n) % n
+		appContextIndex[app.Name] = idx
+		renderDashboard(g)
+		return nil
+	}
+}
+
+// currentSelectedApp returns the application currently under the list
+// cursor, or nil if there's no list view or no selection.
+func currentSelectedApp(g *gocui.Gui) *Application {
+	lv, err := g.View("list")
+	if err != nil {
+		return nil
+	}
+	return getAppFromCursor(lv)
+}
+
+// overviewContext is the original "new tab" dashboard content: host/time
+// info, a featured tip, the current selection, and the Go-Dash banner.
+type overviewContext struct{}
+
+func (overviewContext) Title() string { return "Overview" }
+
+func (overviewContext) Render(dv *gocui.View, app *Application) {
+	hostname, _ := os.Hostname()
+	cwd, _ := os.Getwd()
+	now := time.Now()
+
+	fmt.Fprintf(dv, "Time: %s\n", now.Format("Mon Jan 2, 2006 15:04:05"))
+	fmt.Fprintf(dv, "Host: %s\n", hostname)
+	fmt.Fprintf(dv, "Dir:  %s\n", cwd)
+	fmt.Fprintln(dv, "")
+
+	fmt.Fprintln(dv, "── Featured ─────────────────────────────────────────")
+	fmt.Fprintln(dv, "Pro tip: Bookmark your favorite TUI tools in config.yml.")
+	fmt.Fprintln(dv, "• Keep sessions fast. • Launch with Enter. • Quit with Ctrl+C.")
+	fmt.Fprintln(dv, "")
+
+	fmt.Fprintln(dv, "── Selection ────────────────────────────────────────")
+	if app != nil {
+		fmt.Fprintf(dv, "App: %s\n", app.Name)
+		fmt.Fprintf(dv, "Cmd: %s\n", app.Command)
+	} else {
+		fmt.Fprintln(dv, "No app selected. Use ↑/↓ to choose from the left.")
+	}
+	fmt.Fprintln(dv, "")
+
+	fmt.Fprintln(dv, "── Quick Actions ────────────────────────────────────")
+	fmt.Fprintln(dv, "[Enter] Launch selection   [r] Refresh   [ / ] Switch tab")
+	fmt.Fprintln(dv, "")
+
+	fmt.Fprintln(dv, "── Go-Dash ──────────────────────────────────────────")
+	fmt.Fprintln(dv, "   _____       ____           _     ")
+	fmt.Fprintln(dv, "  / ____|     |  _ \\\\         | |    ")
+	fmt.Fprintln(dv, " | |  __  ___ | |_) | __ _ ___| |__  ")
+	fmt.Fprintln(dv, " | | |_ |/ _ \\\\|  _ < / _` / __| '_ \\")
+	fmt.Fprintln(dv, " | |__| | (_) | |_) | (_| \\\\__ \\\\ | | |")
+	fmt.Fprintln(dv, "  \\\\_____|\\\\___/|____/ \\\\__,_|___/_| |_|")
+}
+
+// envContext shows the resolved binary path and environment variables that
+// look relevant to the selected application's command.
+type envContext struct{}
+
+func (envContext) Title() string { return "Env" }
+
+func (envContext) Render(dv *gocui.View, app *Application) {
+	if app == nil {
+		fmt.Fprintln(dv, "No app selected.")
+		return
+	}
+
+	binary := firstWord(app.Command)
+	fmt.Fprintf(dv, "Binary: %s\n", binary)
+	if path, err := exec.LookPath(binary); err == nil {
+		fmt.Fprintf(dv, "Path:   %s\n", path)
+	} else {
+		fmt.Fprintf(dv, "Path:   not found on $PATH (%v)\n", err)
+	}
+	fmt.Fprintln(dv, "")
+
+	fmt.Fprintln(dv, "── Environment ──────────────────────────────────────")
+	needle := strings.ToUpper(binary)
+	var matched, other []string
+	for _, kv := range os.Environ() {
+		if strings.Contains(strings.ToUpper(kv), needle) {
+			matched = append(matched, kv)
+		} else {
+			other = append(other, kv)
+		}
+	}
+	if len(matched) > 0 {
+		fmt.Fprintln(dv, "Matching", binary+":")
+		for _, kv := range matched {
+			fmt.Fprintln(dv, " ", kv)
+		}
+		fmt.Fprintln(dv, "")
+	}
+	fmt.Fprintln(dv, "PATH:", os.Getenv("PATH"))
+	fmt.Fprintln(dv, "HOME:", os.Getenv("HOME"))
+	fmt.Fprintln(dv, "SHELL:", os.Getenv("SHELL"))
+}
+
+// helpContext runs the selected application's command with --help and
+// shows the output, bounded by a short timeout so a hanging binary can't
+// freeze the dashboard.
+type helpContext struct{}
+
+func (helpContext) Title() string { return "Help" }
+
+func (helpContext) Render(dv *gocui.View, app *Application) {
+	if app == nil {
+		fmt.Fprintln(dv, "No app selected.")
+		return
+	}
+
+	binary := firstWord(app.Command)
+	fmt.Fprintln(dv, "── "+binary+" --help ─────────────────────────────────")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	out, err := exec.CommandContext(ctx, binary, "--help").CombinedOutput()
+	if ctx.Err() == context.DeadlineExceeded {
+		fmt.Fprintln(dv, "(timed out waiting for --help output)")
+		return
+	}
+	if err != nil && len(out) == 0 {
+		fmt.Fprintf(dv, "(could not run %s --help: %v)\n", binary, err)
+		return
+	}
+	fmt.Fprint(dv, string(out))
+}
+
+// recentContext tails the per-app run history log.
+type recentContext struct{}
+
+func (recentContext) Title() string { return "Recent" }
+
+func (recentContext) Render(dv *gocui.View, app *Application) {
+	if app == nil {
+		fmt.Fprintln(dv, "No app selected.")
+		return
+	}
+
+	fmt.Fprintln(dv, "── Recent runs ───────────────────────────────────────")
+	lines, err := tailFile(historyLogPath(app.Name), 20)
+	if err != nil {
+		fmt.Fprintln(dv, "No run history yet.")
+		return
+	}
+	if len(lines) == 0 {
+		fmt.Fprintln(dv, "No run history yet.")
+		return
+	}
+	for _, line := range lines {
+		fmt.Fprintln(dv, line)
+	}
+}
+
+// historyLogPath returns the per-app run history log path, e.g.
+// ~/.config/godash/history/<app-name>.log.
+func historyLogPath(appName string) string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		home = "."
+	}
+	return filepath.Join(home, ".config", "godash", "history", sanitizeAppName(appName)+".log")
+}
+
+// sanitizeAppName strips path separators and leading dots from an
+// Application's Name before it's used to build a file path. Name is
+// arbitrary text - typed into the "Add Application" modal, or loaded from
+// any dashboard profile file under dashboardsDir() - so without this a
+// name like "../../../.ssh/authorized_keys" could point historyLogPath
+// outside the history directory.
+func sanitizeAppName(name string) string {
+	name = filepath.Base(name)
+	name = strings.TrimLeft(name, ".")
+	if name == "" || name == string(filepath.Separator) {
+		name = "app"
+	}
+	return name
+}
+
+// tailFile reads up to the last n lines of path.
+func tailFile(path string, n int) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+		if len(lines) > n {
+			lines = lines[1:]
+		}
+	}
+	return lines, scanner.Err()
+}
+
+// firstWord returns the leading whitespace-delimited token of s, i.e. the
+// binary name out of a shell command line.
+func firstWord(s string) string {
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return s
+	}
+	return fields[0]
+}
@@ -0,0 +1,273 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/jroimartin/gocui"
+	"gopkg.in/yaml.v3"
+)
+
+// dashboardProfiles is every profile the "<"/">" picker can cycle through:
+// config.yml plus every *.yml/*.yaml/*.json file under dashboardsDir().
+// Index 0 is always config.yml.
+var dashboardProfiles []string
+var activeDashboardIndex int
+var dashboardWatcher *fsnotify.Watcher
+
+// pendingEditProfile mirrors pendingApp: set by openProfileEditor, consumed
+// by main's ErrRestart handling once gocui has torn itself down.
+var pendingEditProfile bool
+
+// dashboardsDir is where named dashboard profiles live, alongside the
+// single config.yml in the working directory.
+func dashboardsDir() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		home = "."
+	}
+	return filepath.Join(home, ".config", "godash", "dashboards")
+}
+
+// discoverDashboardProfiles lists config.yml plus every profile file in
+// dashboardsDir(), sorted by name.
+func discoverDashboardProfiles() []string {
+	profiles := []string{yamlConfigPath}
+
+	entries, err := ioutil.ReadDir(dashboardsDir())
+	if err != nil {
+		return profiles
+	}
+
+	var named []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		switch strings.ToLower(filepath.Ext(entry.Name())) {
+		case ".yml", ".yaml", ".json":
+			named = append(named, filepath.Join(dashboardsDir(), entry.Name()))
+		}
+	}
+	sort.Strings(named)
+	return append(profiles, named...)
+}
+
+// dashboardProfileTitle returns a profile's display name: its filename
+// without the extension.
+func dashboardProfileTitle(path string) string {
+	base := filepath.Base(path)
+	return strings.TrimSuffix(base, filepath.Ext(base))
+}
+
+// dashboardListTitle is the sidebar's title, naming the active profile.
+func dashboardListTitle() string {
+	if len(dashboardProfiles) == 0 {
+		return "Go-Dash • Links"
+	}
+	return fmt.Sprintf("Go-Dash • %s", dashboardProfileTitle(dashboardProfiles[activeDashboardIndex]))
+}
+
+// loadProfile reads a dashboard profile, choosing YAML or JSON by
+// extension (unlike config.yml/config.json, named profiles are not
+// migrated - the extension on disk is authoritative).
+func loadProfile(path string) (Config, error) {
+	file, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+
+	var config Config
+	if strings.ToLower(filepath.Ext(path)) == ".json" {
+		err = json.Unmarshal(file, &config)
+	} else {
+		err = yaml.Unmarshal(file, &config)
+	}
+	if err != nil {
+		return config, err
+	}
+	applyGuiDefaults(&config)
+	return config, nil
+}
+
+// cycleDashboardProfile switches the active profile by delta (wrapping),
+// backing the "<" and ">" keybindings.
+func cycleDashboardProfile(delta int) func(g *gocui.Gui, v *gocui.View) error {
+	return func(g *gocui.Gui, v *gocui.View) error {
+		if len(dashboardProfiles) <= 1 {
+			return nil
+		}
+		n := len(dashboardProfiles)
+		activeDashboardIndex = ((activeDashboardIndex+delta)%n + n) % n
+		applyActiveProfile(g)
+		watchActiveProfile()
+		return nil
+	}
+}
+
+// applyActiveProfile loads the active profile from disk into
+// applications/guiConfig and refreshes the list and dashboard. On a parse
+// error the previous applications/guiConfig are left untouched and the
+// error is surfaced in the footer rather than panicking.
+func applyActiveProfile(g *gocui.Gui) {
+	path := dashboardProfiles[activeDashboardIndex]
+	config, err := loadProfile(path)
+	if err != nil {
+		showFooterMessage(fmt.Sprintf("⚠ %s: %v", dashboardProfileTitle(path), err))
+		return
+	}
+	applications = config.Applications
+	guiConfig = config.Gui
+	bulkCommands = config.BulkCommands
+	if lv, lerr := g.View("list"); lerr == nil {
+		lv.Title = dashboardListTitle()
+	}
+	renderList(g)
+	renderDashboard(g)
+}
+
+// showFooterMessage overwrites the footer with msg, used to surface
+// profile parse errors without crashing the TUI.
+func showFooterMessage(msg string) {
+	g := getActiveGui()
+	if g == nil {
+		return
+	}
+	g.Update(func(gg *gocui.Gui) error {
+		fv, err := gg.View("footer")
+		if err != nil {
+			return nil
+		}
+		fv.Clear()
+		fmt.Fprintf(fv, "  %s", msg)
+		return nil
+	})
+}
+
+// openProfileEditor suspends gocui and runs $EDITOR on the active
+// profile, reusing the ErrRestart shutdown path already used for
+// exclusive apps.
+func openProfileEditor(g *gocui.Gui, v *gocui.View) error {
+	pendingEditProfile = true
+	return ErrRestart
+}
+
+// editActiveProfile runs $EDITOR (falling back to vi) on the active
+// profile file. Called from main once gocui has torn down.
+func editActiveProfile() {
+	editor := os.Getenv("EDITOR")
+	if editor == "" {
+		editor = "vi"
+	}
+	path := yamlConfigPath
+	if len(dashboardProfiles) > 0 {
+		path = dashboardProfiles[activeDashboardIndex]
+	}
+
+	cmd := exec.Command(editor, path)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		log.Println("Error running editor:", err)
+	}
+}
+
+// startDashboardWatcher watches the active profile file for changes and
+// hot-reloads applications/guiConfig when it's edited on disk, debouncing
+// rapid-fire writes by ~150ms.
+func startDashboardWatcher() {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		log.Println("Error starting dashboard watcher:", err)
+		return
+	}
+	dashboardWatcher = watcher
+	watchActiveProfile()
+
+	go func() {
+		var debounce *time.Timer
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if event.Op&(fsnotify.Remove|fsnotify.Rename) != 0 {
+					// Many editors save by renaming the original aside and
+					// writing a new file in its place, which drops the
+					// original inode from the watch. Re-add it once the
+					// new file has had a moment to land, then reload, since
+					// the watch on the old inode sees no write to the new
+					// file.
+					go func() {
+						time.Sleep(150 * time.Millisecond)
+						watchActiveProfile()
+						reloadActiveProfileFromWatcher()
+					}()
+				}
+				if event.Op&(fsnotify.Write|fsnotify.Create) == 0 {
+					continue
+				}
+				if debounce != nil {
+					debounce.Stop()
+				}
+				debounce = time.AfterFunc(150*time.Millisecond, reloadActiveProfileFromWatcher)
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				log.Println("Dashboard watcher error:", err)
+			}
+		}
+	}()
+}
+
+// watchedProfilePath is the profile dashboardWatcher currently has an
+// fsnotify watch on, so watchActiveProfile can drop it when the active
+// profile changes instead of accumulating a watch per profile ever visited.
+var watchedProfilePath string
+
+// watchActiveProfile moves the fsnotify watch to the current profile,
+// removing whichever one it was previously watching.
+func watchActiveProfile() {
+	if dashboardWatcher == nil || len(dashboardProfiles) == 0 {
+		return
+	}
+	if watchedProfilePath != "" {
+		dashboardWatcher.Remove(watchedProfilePath)
+	}
+	path := dashboardProfiles[activeDashboardIndex]
+	if err := dashboardWatcher.Add(path); err != nil {
+		log.Println("Error watching dashboard profile:", err)
+		watchedProfilePath = ""
+		return
+	}
+	watchedProfilePath = path
+}
+
+// reloadActiveProfileFromWatcher is applyActiveProfile's entry point from
+// the watcher goroutine, which has no *gocui.Gui of its own to pass
+// through - it uses getActiveGui instead, the same way filterEditor does.
+// getActiveGui is mutex-guarded because this runs on the watcher's
+// long-lived goroutine, concurrently with run() replacing activeGui (or
+// clearing it to nil during teardown) on every restart.
+func reloadActiveProfileFromWatcher() {
+	g := getActiveGui()
+	if g == nil {
+		return
+	}
+	g.Update(func(gg *gocui.Gui) error {
+		applyActiveProfile(gg)
+		return nil
+	})
+}
@@ -9,40 +9,119 @@ import (
 	"os"
 	"os/exec"
 	"os/signal"
+	"path/filepath"
+	"strings"
+	"sync"
 	"syscall"
-	"time"
 
 	"github.com/jroimartin/gocui"
+	"gopkg.in/yaml.v3"
 )
 
-// Config holds the list of applications
+// yamlConfigPath is where config is now read from and written to.
+// jsonConfigPath is kept around as a read-only legacy fallback: if it's
+// the only config present, it's loaded once and migrated to YAML.
+const (
+	yamlConfigPath = "config.yml"
+	jsonConfigPath = "config.json"
+)
+
+// Config holds the list of applications plus gui customization.
 type Config struct {
-	Applications []Application `json:"applications"`
+	Applications []Application `yaml:"applications" json:"applications"`
+	Gui          GuiConfig     `yaml:"gui" json:"gui"`
+	// BulkCommands operate over every application at once (e.g. a
+	// health-check run through each one's binary), offered by the "b"
+	// keybinding, and by "c" when the selected app has no commands of its
+	// own.
+	BulkCommands []Command `yaml:"bulkCommands" json:"bulkCommands"`
+}
+
+// Command is a user-defined action offered from the "c" (per-app) or "b"
+// (bulk) command menu, mirroring lazydocker's custom/bulk commands.
+// Command is a Go template (e.g. "which {{.Command}}") rendered against
+// the Application it runs for.
+type Command struct {
+	Name    string `yaml:"name" json:"name"`
+	Command string `yaml:"command" json:"command"`
+	Confirm bool   `yaml:"confirm" json:"confirm"`
+	Attach  bool   `yaml:"attach" json:"attach"`
+}
+
+// GuiConfig holds display tweaks for the TUI itself, analogous to
+// lazydocker's "gui" config block.
+type GuiConfig struct {
+	SidePanelWidth         float64 `yaml:"sidePanelWidth" json:"sidePanelWidth"`
+	ShowBottomLine         bool    `yaml:"showBottomLine" json:"showBottomLine"`
+	ExpandFocusedSidePanel bool    `yaml:"expandFocusedSidePanel" json:"expandFocusedSidePanel"`
 }
 
 // Application represents a TUI application
 // with a name and the command to execute it
 type Application struct {
-	Name    string `json:"name"`
-	Command string `json:"command"`
+	Name    string `yaml:"name" json:"name"`
+	Command string `yaml:"command" json:"command"`
+	// Exclusive apps (e.g. lazygit) need a full TTY of their own, so they
+	// still go through the old teardown-and-exec path instead of the
+	// task manager's non-blocking output streaming.
+	Exclusive bool `yaml:"exclusive" json:"exclusive"`
+	// CustomCommands are offered from the "c" command menu when this app
+	// is selected, taking priority over the global bulk commands.
+	CustomCommands []Command `yaml:"customCommands" json:"customCommands"`
 }
 
 var applications []Application
+var guiConfig = defaultGuiConfig()
+var bulkCommands []Command
 var ErrRestart = errors.New("restart")
 var pendingApp *Application
 
-func main() {
-	// Create a default config file if it does not exist
-	if _, err := os.Stat("config.json"); os.IsNotExist(err) {
-		createDefaultConfig()
-	}
+// filterQuery holds the text typed into the list filter editor. It persists
+// across renderList refreshes so the filtered view survives selection
+// changes, dashboard refreshes, etc.
+var filterQuery string
+
+// filterActive indicates whether the filter editor view is currently
+// focused and accepting keystrokes.
+var filterActive bool
+
+// activeGui is the running *gocui.Gui, stashed so gocui.Editor callbacks
+// (which don't receive it) can trigger a re-render. It's replaced on every
+// restart (ErrRestart) while the dashboard watcher's background goroutine
+// keeps reading it independently, so all access goes through
+// setActiveGui/getActiveGui rather than the bare var.
+var (
+	activeGuiMu sync.Mutex
+	activeGui   *gocui.Gui
+)
+
+// setActiveGui records the *gocui.Gui for the current run, or clears it
+// (nil) once that run has torn down.
+func setActiveGui(g *gocui.Gui) {
+	activeGuiMu.Lock()
+	activeGui = g
+	activeGuiMu.Unlock()
+}
+
+// getActiveGui returns the *gocui.Gui for the current run, or nil between
+// runs (e.g. while $EDITOR is suspended during a profile edit).
+func getActiveGui() *gocui.Gui {
+	activeGuiMu.Lock()
+	defer activeGuiMu.Unlock()
+	return activeGui
+}
 
-	// Load the configuration
-	config, err := loadConfig("config.json")
+func main() {
+	config, err := loadOrCreateConfig()
 	if err != nil {
 		log.Panicln("Error loading config:", err)
 	}
 	applications = config.Applications
+	guiConfig = config.Gui
+	bulkCommands = config.BulkCommands
+
+	dashboardProfiles = discoverDashboardProfiles()
+	startDashboardWatcher()
 
 	for {
 		if err := run(); err != nil {
@@ -53,6 +132,11 @@ func main() {
 					pendingApp = nil
 					runApplication(app)
 				}
+				// Or a pending dashboard profile to edit
+				if pendingEditProfile {
+					pendingEditProfile = false
+					editActiveProfile()
+				}
 				continue
 			}
 			if err != gocui.ErrQuit {
@@ -70,6 +154,8 @@ func run() error {
 		return err
 	}
 	defer g.Close()
+	setActiveGui(g)
+	defer setActiveGui(nil)
 
 	// Show the text cursor for editable views
 	g.Cursor = true
@@ -96,6 +182,105 @@ func run() error {
 	if err := g.SetKeybinding("list", 'a', gocui.ModNone, openAddModal); err != nil {
 		return err
 	}
+	// Incremental filter ("/" search mode)
+	if err := g.SetKeybinding("list", '/', gocui.ModNone, openFilterEditor); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding("filter", gocui.KeyEnter, gocui.ModNone, commitFilter); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding("filter", gocui.KeyEsc, gocui.ModNone, clearFilter); err != nil {
+		return err
+	}
+	// Dashboard tab cycling
+	if err := g.SetKeybinding("list", '[', gocui.ModNone, cycleDashboardContext(-1)); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding("list", ']', gocui.ModNone, cycleDashboardContext(1)); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding("dashboard", '[', gocui.ModNone, cycleDashboardContext(-1)); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding("dashboard", ']', gocui.ModNone, cycleDashboardContext(1)); err != nil {
+		return err
+	}
+	// Task manager: background the active task, or pick between tasks
+	if err := g.SetKeybinding("output", 'b', gocui.ModNone, backgroundActiveTask); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding("list", 't', gocui.ModNone, openTaskPicker); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding("task_picker", gocui.KeyArrowDown, gocui.ModNone, taskPickerCursorDown); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding("task_picker", gocui.KeyArrowUp, gocui.ModNone, taskPickerCursorUp); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding("task_picker", gocui.KeyEnter, gocui.ModNone, selectTask); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding("task_picker", gocui.KeyEsc, gocui.ModNone, closeTaskPicker); err != nil {
+		return err
+	}
+	// Dashboard profile picker and $EDITOR integration
+	if err := g.SetKeybinding("list", '<', gocui.ModNone, cycleDashboardProfile(-1)); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding("list", '>', gocui.ModNone, cycleDashboardProfile(1)); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding("list", 'e', gocui.ModNone, openProfileEditor); err != nil {
+		return err
+	}
+	// Per-app and bulk custom commands
+	if err := g.SetKeybinding("list", 'c', gocui.ModNone, openCommandMenu); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding("list", 'b', gocui.ModNone, openBulkMenu); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding("command_menu", gocui.KeyArrowDown, gocui.ModNone, commandMenuCursorDown); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding("command_menu", gocui.KeyArrowUp, gocui.ModNone, commandMenuCursorUp); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding("command_menu", gocui.KeyEnter, gocui.ModNone, runSelectedCommand); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding("command_menu", gocui.KeyEsc, gocui.ModNone, closeCommandMenu); err != nil {
+		return err
+	}
+	// New custom/bulk command modal, opened with "n" from the command menu
+	if err := g.SetKeybinding("command_menu", 'n', gocui.ModNone, openNewCommandModal); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding("new_command_name", gocui.KeyEnter, gocui.ModNone, switchCommandField); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding("new_command_cmd", gocui.KeyEnter, gocui.ModNone, saveNewCommand); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding("new_command_name", gocui.KeyTab, gocui.ModNone, switchCommandField); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding("new_command_cmd", gocui.KeyTab, gocui.ModNone, switchCommandField); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding("new_command_name", gocui.KeyEsc, gocui.ModNone, cancelNewCommandModal); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding("new_command_cmd", gocui.KeyEsc, gocui.ModNone, cancelNewCommandModal); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding("confirmation", gocui.KeyEnter, gocui.ModNone, confirmConfirmation); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding("confirmation", gocui.KeyEsc, gocui.ModNone, cancelConfirmation); err != nil {
+		return err
+	}
 	// Modal controls
 	if err := g.SetKeybinding("add_name", gocui.KeyEnter, gocui.ModNone, switchAddField); err != nil {
 		// ignore at startup; views may not exist yet
@@ -113,8 +298,70 @@ func run() error {
 	return g.MainLoop()
 }
 
-// loadConfig reads and parses the config.json file
+// defaultGuiConfig returns the gui settings written into a fresh install's
+// config.yml and used before any config has been loaded. Loaded configs
+// (including a migrated legacy config.json) only take its SidePanelWidth,
+// via applyGuiDefaults.
+func defaultGuiConfig() GuiConfig {
+	return GuiConfig{
+		SidePanelWidth:         0.333,
+		ShowBottomLine:         true,
+		ExpandFocusedSidePanel: false,
+	}
+}
+
+// applyGuiDefaults fills in a missing (zero or negative) SidePanelWidth in
+// place and leaves the rest of the Gui block as loaded, so a config that
+// only sets e.g. showBottomLine keeps it. The boolean fields are not
+// defaulted: an omitted showBottomLine or expandFocusedSidePanel reads as
+// false, the same as an explicit false.
+func applyGuiDefaults(config *Config) {
+	defaults := defaultGuiConfig()
+	if config.Gui.SidePanelWidth <= 0 {
+		config.Gui.SidePanelWidth = defaults.SidePanelWidth
+	}
+}
+
+// loadOrCreateConfig loads config.yml. If it doesn't exist but a legacy
+// config.json does, that's loaded once and migrated to YAML. If neither
+// exists, a fresh default config.yml is created.
+func loadOrCreateConfig() (Config, error) {
+	if _, err := os.Stat(yamlConfigPath); err == nil {
+		return loadConfig(yamlConfigPath)
+	}
+
+	if _, err := os.Stat(jsonConfigPath); err == nil {
+		config, err := loadLegacyJSONConfig(jsonConfigPath)
+		if err != nil {
+			return config, err
+		}
+		applyGuiDefaults(&config)
+		if err := writeConfig(yamlConfigPath, config); err != nil {
+			log.Println("Error migrating config.json to config.yml:", err)
+		}
+		return config, nil
+	}
+
+	createDefaultConfig()
+	return loadConfig(yamlConfigPath)
+}
+
+// loadConfig reads and parses a YAML config file.
 func loadConfig(path string) (Config, error) {
+	var config Config
+	file, err := ioutil.ReadFile(path)
+	if err != nil {
+		return config, err
+	}
+	if err := yaml.Unmarshal(file, &config); err != nil {
+		return config, err
+	}
+	applyGuiDefaults(&config)
+	return config, nil
+}
+
+// loadLegacyJSONConfig reads the pre-YAML config.json format.
+func loadLegacyJSONConfig(path string) (Config, error) {
 	var config Config
 	file, err := ioutil.ReadFile(path)
 	if err != nil {
@@ -124,7 +371,7 @@ func loadConfig(path string) (Config, error) {
 	return config, err
 }
 
-// createDefaultConfig creates a default config.json file
+// createDefaultConfig creates a default config.yml file
 func createDefaultConfig() {
 	config := Config{
 		Applications: []Application{
@@ -132,40 +379,92 @@ func createDefaultConfig() {
 			{Name: "LazyDocker", Command: "lazydocker"},
 			{Name: "LazySSH", Command: "lazyssh"},
 		},
+		Gui: defaultGuiConfig(),
 	}
 
-	file, err := json.MarshalIndent(config, "", "  ")
-	if err != nil {
+	if err := writeConfig(yamlConfigPath, config); err != nil {
 		log.Panicln("Error creating default config:", err)
 	}
+}
 
-	if err := ioutil.WriteFile("config.json", file, 0644); err != nil {
-		log.Panicln("Error creating default config:", err)
+// computeSidebarWidth applies the configured side-panel ratio to the
+// terminal width, clamping it so there's always room for both panels.
+func computeSidebarWidth(maxX int, ratio float64) int {
+	width := int(float64(maxX) * ratio)
+	if width < 24 {
+		width = 24
+	}
+	if width > maxX-30 { // keep space for dashboard
+		width = maxX - 30
+		if width < 24 {
+			width = 24
+		}
 	}
+	return width
+}
+
+// computePanelBounds returns the bottom y-coordinate for the list and
+// dashboard views. When ExpandFocusedSidePanel is set, the focused panel
+// keeps its full height while the other shrinks (an accordion effect).
+func computePanelBounds(maxY int, cfg GuiConfig, focused string) (listBottom, dashBottom int) {
+	bottomMargin := 1
+	if cfg.ShowBottomLine {
+		bottomMargin = 2
+	}
+	listBottom = maxY - bottomMargin
+	dashBottom = maxY - bottomMargin
+	if !cfg.ExpandFocusedSidePanel {
+		return listBottom, dashBottom
+	}
+
+	const accordionShrink = 4
+	switch focused {
+	case "list", "filter":
+		dashBottom -= accordionShrink
+	case "dashboard":
+		listBottom -= accordionShrink
+	}
+	if listBottom < 3 {
+		listBottom = 3
+	}
+	if dashBottom < 3 {
+		dashBottom = 3
+	}
+	return listBottom, dashBottom
+}
+
+// bottomStripBounds returns the y0/y1 SetView bounds for the single-row
+// strip reserved below the list/dashboard panels (the footer and, in
+// place of it, the filter editor). It mirrors computePanelBounds's own
+// bottomMargin so the strip always sits in the row those panels actually
+// leave free, whether or not the footer itself is shown.
+func bottomStripBounds(maxY int, cfg GuiConfig) (top, bottom int) {
+	bottomMargin := 1
+	if cfg.ShowBottomLine {
+		bottomMargin = 2
+	}
+	top = maxY - bottomMargin
+	return top, top + 1
 }
 
 // layout sets up the view
 func layout(g *gocui.Gui) error {
 	maxX, maxY := g.Size()
 
-	// Determine sidebar width (about 30% of the screen, min 24 cols)
-	sidebarWidth := maxX / 3
-	if sidebarWidth < 24 {
-		sidebarWidth = 24
-	}
-	if sidebarWidth > maxX-30 { // keep space for dashboard
-		sidebarWidth = maxX - 30
-		if sidebarWidth < 24 {
-			sidebarWidth = 24
-		}
+	sidebarWidth := computeSidebarWidth(maxX, guiConfig.SidePanelWidth)
+
+	focused := ""
+	if cv := g.CurrentView(); cv != nil {
+		focused = cv.Name()
 	}
+	listBottom, dashBottom := computePanelBounds(maxY, guiConfig, focused)
 
 	// Sidebar: application list
-	if v, err := g.SetView("list", 0, 0, sidebarWidth-1, maxY-2); err != nil {
+	if v, err := g.SetView("list", 0, 0, sidebarWidth-1, listBottom); err != nil {
 		if err != gocui.ErrUnknownView {
 			return err
 		}
-		v.Title = "Go-Dash • Links"
+		v.Title = dashboardListTitle()
 		v.Highlight = true
 		v.SelBgColor = gocui.ColorGreen
 		v.SelFgColor = gocui.ColorBlack
@@ -180,22 +479,136 @@ func layout(g *gocui.Gui) error {
 		}
 	}
 
-	// Dashboard: right panel
-	if dv, err := g.SetView("dashboard", sidebarWidth, 0, maxX-1, maxY-2); err != nil {
-		if err != gocui.ErrUnknownView {
+	// Dashboard / output: the right panel shows the selected app's
+	// dashboard context, or the streamed output of its active background
+	// task once one has been launched (runApp / taskManager.Start).
+	if taskManager.ActiveTask() != nil {
+		if _, err := g.View("dashboard"); err == nil {
+			if err := g.DeleteView("dashboard"); err != nil {
+				return err
+			}
+		}
+		if ov, err := g.SetView("output", sidebarWidth, 0, maxX-1, dashBottom); err != nil {
+			if err != gocui.ErrUnknownView {
+				return err
+			}
+			ov.Wrap = true
+			ov.Autoscroll = true
+			renderOutputView(g)
+			if _, err := g.SetCurrentView("output"); err != nil {
+				return err
+			}
+		}
+
+		// Loader overlay: spinner + elapsed time while the task is running.
+		if lv, err := g.SetView("loader", maxX-24, 0, maxX-2, 2); err != nil {
+			if err != gocui.ErrUnknownView {
+				return err
+			}
+			lv.Title = "Running"
+			lv.Frame = true
+		}
+		renderLoaderView(g)
+	} else {
+		if _, err := g.View("output"); err == nil {
+			if err := g.DeleteView("output"); err != nil {
+				return err
+			}
+			if _, err := g.SetCurrentView("list"); err != nil {
+				return err
+			}
+		}
+		if _, err := g.View("loader"); err == nil {
+			if err := g.DeleteView("loader"); err != nil {
+				return err
+			}
+		}
+		if dv, err := g.SetView("dashboard", sidebarWidth, 0, maxX-1, dashBottom); err != nil {
+			if err != gocui.ErrUnknownView {
+				return err
+			}
+			dv.Title = "New Tab • Dashboard"
+			renderDashboard(g)
+		}
+	}
+
+	// Task picker: lists every task (running or finished) so the user can
+	// switch the output view between backgrounded tasks.
+	if taskPickerOpen {
+		if err := renderTaskPicker(g); err != nil {
+			return err
+		}
+	} else if _, err := g.View("task_picker"); err == nil {
+		if err := g.DeleteView("task_picker"); err != nil {
+			return err
+		}
+		// Hand focus to the output view when a task is shown, so "b" can
+		// background it.
+		focus := "list"
+		if taskManager.ActiveTask() != nil {
+			focus = "output"
+		}
+		if _, err := g.SetCurrentView(focus); err != nil {
+			return err
+		}
+	}
+
+	// Command menu: the selected app's custom commands, or the global
+	// bulk commands, opened with "c" / "b" on the list.
+	if commandMenuOpen {
+		if err := renderCommandMenu(g); err != nil {
+			return err
+		}
+	} else if _, err := g.View("command_menu"); err == nil {
+		if err := g.DeleteView("command_menu"); err != nil {
+			return err
+		}
+		if _, err := g.SetCurrentView("list"); err != nil {
 			return err
 		}
-		dv.Title = "New Tab • Dashboard"
-		renderDashboard(g)
 	}
 
 	// Footer/status bar
-	if fv, err := g.SetView("footer", 0, maxY-2, maxX-1, maxY-1); err != nil {
-		if err != gocui.ErrUnknownView {
+	stripTop, stripBottom := bottomStripBounds(maxY, guiConfig)
+	if guiConfig.ShowBottomLine {
+		if fv, err := g.SetView("footer", 0, stripTop, maxX-1, stripBottom); err != nil {
+			if err != gocui.ErrUnknownView {
+				return err
+			}
+			fv.Frame = false
+			fmt.Fprintf(fv, "  ↑/↓ Move   Enter Launch   / Filter   [/] Tab   t Tasks   </> Dashboard   e Edit   c Commands   b Bulk   a Add   r Refresh   Ctrl+C Quit  ")
+		}
+	} else if _, err := g.View("footer"); err == nil {
+		if err := g.DeleteView("footer"); err != nil {
+			return err
+		}
+	}
+
+	// Filter editor: shown in place of the footer while the user is typing
+	// a list filter. "/" on list opens it, Enter commits, Esc clears.
+	if filterActive {
+		if fev, err := g.SetView("filter", 0, stripTop, maxX-1, stripBottom); err != nil {
+			if err != gocui.ErrUnknownView {
+				return err
+			}
+			fev.Frame = false
+			fev.Editable = true
+			fev.Editor = gocui.EditorFunc(filterEditor)
+			fmt.Fprintf(fev, "/%s", filterQuery)
+			if err := fev.SetCursor(len(filterQuery)+1, 0); err != nil {
+				return err
+			}
+			if _, err := g.SetCurrentView("filter"); err != nil {
+				return err
+			}
+		}
+	} else if _, err := g.View("filter"); err == nil {
+		if err := g.DeleteView("filter"); err != nil {
+			return err
+		}
+		if _, err := g.SetCurrentView("list"); err != nil {
 			return err
 		}
-		fv.Frame = false
-		fmt.Fprintf(fv, "  ↑/↓ Move   Enter Launch   a Add   r Refresh   Ctrl+C Quit  ")
 	}
 
 	return nil
@@ -209,7 +622,7 @@ func quit(g *gocui.Gui, v *gocui.View) error {
 // cursorDown moves the cursor down in the list
 func cursorDown(g *gocui.Gui, v *gocui.View) error {
 	_, y := v.Cursor()
-	if y < len(applications)+1 {
+	if y < len(visibleApplications())+1 {
 		v.MoveCursor(0, 1, false)
 	}
 	// Refresh dashboard to reflect selection change
@@ -228,13 +641,34 @@ func cursorUp(g *gocui.Gui, v *gocui.View) error {
 	return nil
 }
 
-// getAppFromCursor returns the application from the current cursor position
+// visibleApplications returns the applications matching filterQuery (a
+// case-insensitive substring match on Name or Command). With an empty
+// query it is simply `applications`. Cursor navigation, launching and
+// renderList all go through this so the filter behaves as a real view
+// over the underlying slice rather than a cosmetic overlay.
+func visibleApplications() []Application {
+	if filterQuery == "" {
+		return applications
+	}
+	q := strings.ToLower(filterQuery)
+	filtered := make([]Application, 0, len(applications))
+	for _, app := range applications {
+		if strings.Contains(strings.ToLower(app.Name), q) || strings.Contains(strings.ToLower(app.Command), q) {
+			filtered = append(filtered, app)
+		}
+	}
+	return filtered
+}
+
+// getAppFromCursor returns the application from the current cursor position,
+// indexing into the filtered view rather than the full application list.
 func getAppFromCursor(v *gocui.View) *Application {
 	_, y := v.Cursor()
 	// The first two lines are the title and a blank line
 	index := y - 2
-	if index >= 0 && index < len(applications) {
-		return &applications[index]
+	apps := visibleApplications()
+	if index >= 0 && index < len(apps) {
+		return &apps[index]
 	}
 	return nil
 }
@@ -246,12 +680,26 @@ func runApp(g *gocui.Gui, v *gocui.View) error {
 		return nil
 	}
 
-	// Store the app to run after gocui closes
-	pendingApp = app
+	if app.Exclusive {
+		// Exclusive apps need the whole terminal to themselves. Store the
+		// app to run after gocui closes and return ErrRestart to close
+		// gocui, which will restore the terminal; the main loop then calls
+		// runApplication to actually run the command.
+		pendingApp = app
+		return ErrRestart
+	}
+
+	// Everything else runs through the task manager: non-blocking, with
+	// output streamed into the "output" view instead of tearing down gocui.
+	taskManager.Start(g, app)
+	return nil
+}
 
-	// Return ErrRestart to close gocui, which will restore the terminal
-	// The main loop will then call runApplication to actually run the command
-	return ErrRestart
+// backgroundActiveTask hides the output view for the active task and
+// returns focus to the list, without stopping the task itself.
+func backgroundActiveTask(g *gocui.Gui, v *gocui.View) error {
+	taskManager.Background()
+	return nil
 }
 
 // runApplication executes the application after gocui has closed
@@ -308,6 +756,8 @@ func runApplication(app *Application) {
 }
 
 // renderDashboard paints the right-side panel with a "new tab" style
+// dashboard: the selected application's currently active DashboardContext
+// (Overview, Env, Help, Recent, ...), cycled with "[" and "]".
 func renderDashboard(g *gocui.Gui) {
 	g.Update(func(gg *gocui.Gui) error {
 		dv, err := gg.View("dashboard")
@@ -316,56 +766,10 @@ func renderDashboard(g *gocui.Gui) {
 		}
 		dv.Clear()
 
-		// Gather dynamic info
-		hostname, _ := os.Hostname()
-		cwd, _ := os.Getwd()
-		now := time.Now()
-
-		// Selected app info (if any)
-		var selectedName string
-		var selectedCmd string
-		if lv, lerr := gg.View("list"); lerr == nil {
-			if app := getAppFromCursor(lv); app != nil {
-				selectedName = app.Name
-				selectedCmd = app.Command
-			}
-		}
-
-		// Header
-		fmt.Fprintf(dv, "Time: %s\n", now.Format("Mon Jan 2, 2006 15:04:05"))
-		fmt.Fprintf(dv, "Host: %s\n", hostname)
-		fmt.Fprintf(dv, "Dir:  %s\n", cwd)
-		fmt.Fprintln(dv, "")
-
-		// Featured tile
-		fmt.Fprintln(dv, "── Featured ─────────────────────────────────────────")
-		fmt.Fprintln(dv, "Pro tip: Bookmark your favorite TUI tools in config.json.")
-		fmt.Fprintln(dv, "• Keep sessions fast. • Launch with Enter. • Quit with Ctrl+C.")
-		fmt.Fprintln(dv, "")
-
-		// Selected app details
-		fmt.Fprintln(dv, "── Selection ────────────────────────────────────────")
-		if selectedName != "" {
-			fmt.Fprintf(dv, "App: %s\n", selectedName)
-			fmt.Fprintf(dv, "Cmd: %s\n", selectedCmd)
-		} else {
-			fmt.Fprintln(dv, "No app selected. Use ↑/↓ to choose from the left.")
-		}
-		fmt.Fprintln(dv, "")
-
-		// Quick actions
-		fmt.Fprintln(dv, "── Quick Actions ────────────────────────────────────")
-		fmt.Fprintln(dv, "[Enter] Launch selection   [r] Refresh dashboard")
-		fmt.Fprintln(dv, "")
-
-		// ASCII brand
-		fmt.Fprintln(dv, "── Go-Dash ──────────────────────────────────────────")
-		fmt.Fprintln(dv, "   _____       ____           _     ")
-		fmt.Fprintln(dv, "  / ____|     |  _ \\\\         | |    ")
-		fmt.Fprintln(dv, " | |  __  ___ | |_) | __ _ ___| |__  ")
-		fmt.Fprintln(dv, " | | |_ |/ _ \\\\|  _ < / _` / __| '_ \\")
-		fmt.Fprintln(dv, " | |__| | (_) | |_) | (_| \\\\__ \\\\ | | |")
-		fmt.Fprintln(dv, "  \\\\_____|\\\\___/|____/ \\\\__,_|___/_| |_|")
+		app := currentSelectedApp(gg)
+		ctx := activeContextFor(app)
+		dv.Title = fmt.Sprintf("New Tab • %s", ctx.Title())
+		ctx.Render(dv, app)
 		return nil
 	})
 }
@@ -384,15 +788,51 @@ func renderList(g *gocui.Gui) {
 			return nil
 		}
 		lv.Clear()
-		fmt.Fprintln(lv, "Welcome to Go-Dash! Select an app and press Enter.")
+		if filterQuery != "" {
+			fmt.Fprintf(lv, "Welcome to Go-Dash! Filter: %q (Esc clears)\n", filterQuery)
+		} else {
+			fmt.Fprintln(lv, "Welcome to Go-Dash! Select an app and press Enter.")
+		}
 		fmt.Fprintln(lv, "")
-		for _, app := range applications {
+		for _, app := range visibleApplications() {
 			fmt.Fprintln(lv, app.Name)
 		}
 		return nil
 	})
 }
 
+// openFilterEditor switches the list into incremental filter mode. layout
+// creates the "filter" view and gives it focus on the next frame.
+func openFilterEditor(g *gocui.Gui, v *gocui.View) error {
+	filterActive = true
+	return nil
+}
+
+// filterEditor wraps gocui's default editor so every keystroke in the
+// filter view updates filterQuery and re-renders the list immediately.
+func filterEditor(v *gocui.View, key gocui.Key, ch rune, mod gocui.Modifier) {
+	gocui.DefaultEditor.Edit(v, key, ch, mod)
+	filterQuery = strings.TrimPrefix(trimViewText(v), "/")
+	if g := getActiveGui(); g != nil {
+		renderList(g)
+	}
+}
+
+// commitFilter closes the filter editor, keeping whatever was typed as the
+// active filter.
+func commitFilter(g *gocui.Gui, v *gocui.View) error {
+	filterActive = false
+	return nil
+}
+
+// clearFilter closes the filter editor and discards the current filter.
+func clearFilter(g *gocui.Gui, v *gocui.View) error {
+	filterQuery = ""
+	filterActive = false
+	renderList(g)
+	return nil
+}
+
 // openAddModal shows a centered modal with two inputs: name and command
 func openAddModal(g *gocui.Gui, v *gocui.View) error {
 	maxX, maxY := g.Size()
@@ -472,7 +912,7 @@ func saveNewApp(g *gocui.Gui, v *gocui.View) error {
 		return nil
 	}
 	applications = append(applications, Application{Name: name, Command: cmd})
-	if err := writeConfig("config.json", applications); err != nil {
+	if err := writeConfig(activeProfilePath(), Config{Applications: applications, Gui: guiConfig, BulkCommands: bulkCommands}); err != nil {
 		log.Println("Error writing config:", err)
 	}
 	// close modal
@@ -512,12 +952,28 @@ func trimViewText(v *gocui.View) string {
 	return text
 }
 
-// writeConfig persists the applications to disk
-func writeConfig(path string, apps []Application) error {
-	conf := Config{Applications: apps}
-	file, err := json.MarshalIndent(conf, "", "  ")
+// writeConfig persists a config to disk as YAML, or as JSON when path has
+// a .json extension.
+func writeConfig(path string, config Config) error {
+	var file []byte
+	var err error
+	if strings.ToLower(filepath.Ext(path)) == ".json" {
+		file, err = json.MarshalIndent(config, "", "  ")
+	} else {
+		file, err = yaml.Marshal(config)
+	}
 	if err != nil {
 		return err
 	}
 	return ioutil.WriteFile(path, file, 0644)
 }
+
+// activeProfilePath returns the file backing the currently active
+// dashboard profile, falling back to config.yml if no profile has been
+// discovered yet.
+func activeProfilePath() string {
+	if len(dashboardProfiles) == 0 {
+		return yamlConfigPath
+	}
+	return dashboardProfiles[activeDashboardIndex]
+}
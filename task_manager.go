@@ -0,0 +1,390 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/jroimartin/gocui"
+)
+
+// spinnerFrames are cycled every 100ms to animate the loader view, lifted
+// from lazygit's braille spinner.
+var spinnerFrames = []rune("⠋⠙⠹⠸⠼⠴⠦⠧⠇⠏")
+
+// taskManager owns every task launched via runApp for the lifetime of the
+// process.
+var taskManager = newTaskManager()
+
+// Task is one in-flight (or finished) run of an Application's command.
+type Task struct {
+	ID  int
+	App *Application
+
+	mu        sync.Mutex
+	output    []byte
+	done      bool
+	err       error
+	startedAt time.Time
+	endedAt   time.Time
+}
+
+// Output returns a snapshot of everything the task has produced so far.
+func (t *Task) Output() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return string(t.output)
+}
+
+// Done reports whether the task's process has exited.
+func (t *Task) Done() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.done
+}
+
+// Err returns the task's exit error, if any, once it has finished.
+func (t *Task) Err() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.err
+}
+
+// Elapsed returns how long the task has been (or was) running.
+func (t *Task) Elapsed() time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.done {
+		return t.endedAt.Sub(t.startedAt)
+	}
+	return time.Since(t.startedAt)
+}
+
+func (t *Task) appendOutput(p []byte) {
+	t.mu.Lock()
+	t.output = append(t.output, p...)
+	t.mu.Unlock()
+}
+
+func (t *Task) finish(err error) {
+	t.mu.Lock()
+	t.done = true
+	t.err = err
+	t.endedAt = time.Now()
+	t.mu.Unlock()
+}
+
+// TaskManager runs one goroutine per launched Application, streaming its
+// combined stdout/stderr into a buffer that the "output" view renders,
+// instead of tearing down gocui the way the exclusive-app teardown path
+// does. Non-exclusive commands stay backgroundable: the user can hide the
+// output view (`b`), go back to the list, launch another app, and switch
+// between running/finished tasks with the task picker (`t`).
+type TaskManager struct {
+	mu        sync.Mutex
+	tasks     []*Task
+	nextID    int
+	activeID  int // 0 means "no active task"
+	spinnerAt int
+	ticking   bool
+}
+
+func newTaskManager() *TaskManager {
+	return &TaskManager{}
+}
+
+// Start launches app's command in the background and makes it the active
+// (displayed) task. It never blocks: control returns to the caller as soon
+// as the process has been started.
+func (tm *TaskManager) Start(g *gocui.Gui, app *Application) *Task {
+	tm.mu.Lock()
+	tm.nextID++
+	task := &Task{ID: tm.nextID, App: app, startedAt: time.Now()}
+	tm.tasks = append(tm.tasks, task)
+	tm.activeID = task.ID
+	tm.mu.Unlock()
+
+	cmd := exec.Command("sh", "-c", app.Command)
+	reader, writer := io.Pipe()
+	cmd.Stdout = writer
+	cmd.Stderr = writer
+
+	if err := cmd.Start(); err != nil {
+		task.finish(err)
+		writer.Close()
+		tm.refresh(g)
+		return task
+	}
+
+	go func() {
+		scanner := bufio.NewScanner(reader)
+		scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			task.appendOutput(append(scanner.Bytes(), '\n'))
+			tm.refresh(g)
+		}
+	}()
+
+	go func() {
+		err := cmd.Wait()
+		writer.Close()
+		appendRunHistory(app.Name, err)
+		task.finish(err)
+		tm.refresh(g)
+	}()
+
+	tm.startSpinner(g)
+	return task
+}
+
+// ActiveTask returns the task currently shown in the output view, or nil.
+func (tm *TaskManager) ActiveTask() *Task {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+	for _, t := range tm.tasks {
+		if t.ID == tm.activeID {
+			return t
+		}
+	}
+	return nil
+}
+
+// Tasks returns every task the manager has ever launched, oldest first.
+func (tm *TaskManager) Tasks() []*Task {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+	out := make([]*Task, len(tm.tasks))
+	copy(out, tm.tasks)
+	return out
+}
+
+// SetActive switches which task is displayed in the output view.
+func (tm *TaskManager) SetActive(id int) {
+	tm.mu.Lock()
+	tm.activeID = id
+	tm.mu.Unlock()
+}
+
+// Background clears the active task so layout hides the output view and
+// returns focus to the list, without stopping the underlying process.
+func (tm *TaskManager) Background() {
+	tm.mu.Lock()
+	tm.activeID = 0
+	tm.mu.Unlock()
+}
+
+func (tm *TaskManager) anyRunning() bool {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+	for _, t := range tm.tasks {
+		if !t.done {
+			return true
+		}
+	}
+	return false
+}
+
+func (tm *TaskManager) spinnerFrame() rune {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+	return spinnerFrames[tm.spinnerAt]
+}
+
+// refresh re-renders whatever's currently on screen for the task manager:
+// the output view and the loader overlay.
+func (tm *TaskManager) refresh(g *gocui.Gui) {
+	renderOutputView(g)
+	renderLoaderView(g)
+}
+
+// startSpinner runs a single 100ms ticker (shared by every task) that
+// advances the loader animation until nothing is running any more.
+func (tm *TaskManager) startSpinner(g *gocui.Gui) {
+	tm.mu.Lock()
+	if tm.ticking {
+		tm.mu.Unlock()
+		return
+	}
+	tm.ticking = true
+	tm.mu.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(100 * time.Millisecond)
+		defer ticker.Stop()
+		for range ticker.C {
+			if !tm.anyRunning() {
+				tm.mu.Lock()
+				tm.ticking = false
+				tm.mu.Unlock()
+				return
+			}
+			tm.mu.Lock()
+			tm.spinnerAt = (tm.spinnerAt + 1) % len(spinnerFrames)
+			tm.mu.Unlock()
+			tm.refresh(g)
+		}
+	}()
+}
+
+// renderOutputView paints the active task's streamed output into the
+// "output" view. Safe to call from any goroutine.
+func renderOutputView(g *gocui.Gui) {
+	g.Update(func(gg *gocui.Gui) error {
+		ov, err := gg.View("output")
+		if err != nil {
+			return nil
+		}
+		ov.Clear()
+		task := taskManager.ActiveTask()
+		if task == nil {
+			return nil
+		}
+		status := "running"
+		if task.Done() {
+			status = "exited"
+			if e := task.Err(); e != nil {
+				status = fmt.Sprintf("exited: %v", e)
+			}
+		}
+		ov.Title = fmt.Sprintf("New Tab • %s (%s)", task.App.Name, status)
+		fmt.Fprint(ov, task.Output())
+		return nil
+	})
+}
+
+// renderLoaderView paints the spinner + elapsed time overlay while the
+// active task is still running. Safe to call from any goroutine.
+func renderLoaderView(g *gocui.Gui) {
+	g.Update(func(gg *gocui.Gui) error {
+		lv, err := gg.View("loader")
+		if err != nil {
+			return nil
+		}
+		lv.Clear()
+		task := taskManager.ActiveTask()
+		if task == nil || task.Done() {
+			return nil
+		}
+		fmt.Fprintf(lv, " %c %s", taskManager.spinnerFrame(), task.Elapsed().Round(time.Second))
+		return nil
+	})
+}
+
+// taskPickerOpen and taskPickerCursor drive the "task_picker" modal used
+// to switch the output view between running/finished tasks.
+var taskPickerOpen bool
+var taskPickerCursor int
+
+// openTaskPicker shows the task picker modal.
+func openTaskPicker(g *gocui.Gui, v *gocui.View) error {
+	if len(taskManager.Tasks()) == 0 {
+		return nil
+	}
+	taskPickerOpen = true
+	taskPickerCursor = 0
+	return nil
+}
+
+// closeTaskPicker hides the task picker modal without changing the active
+// task.
+func closeTaskPicker(g *gocui.Gui, v *gocui.View) error {
+	taskPickerOpen = false
+	return nil
+}
+
+// selectTask makes the task under the picker's cursor the active one and
+// closes the modal.
+func selectTask(g *gocui.Gui, v *gocui.View) error {
+	tasks := taskManager.Tasks()
+	if taskPickerCursor >= 0 && taskPickerCursor < len(tasks) {
+		taskManager.SetActive(tasks[taskPickerCursor].ID)
+		// The "output" view may already exist from a previous task, so it
+		// won't get re-populated by layout's create-only branch; refresh
+		// it explicitly to show the newly selected task right away.
+		renderOutputView(g)
+		renderLoaderView(g)
+	}
+	taskPickerOpen = false
+	return nil
+}
+
+// taskPickerCursorDown / taskPickerCursorUp move the picker's selection.
+func taskPickerCursorDown(g *gocui.Gui, v *gocui.View) error {
+	if taskPickerCursor < len(taskManager.Tasks())-1 {
+		taskPickerCursor++
+	}
+	return nil
+}
+
+func taskPickerCursorUp(g *gocui.Gui, v *gocui.View) error {
+	if taskPickerCursor > 0 {
+		taskPickerCursor--
+	}
+	return nil
+}
+
+// renderTaskPicker creates (if needed) and redraws the centered task
+// picker modal.
+func renderTaskPicker(g *gocui.Gui) error {
+	maxX, maxY := g.Size()
+	width := 60
+	height := 12
+	if width > maxX-4 {
+		width = maxX - 4
+	}
+	if height > maxY-4 {
+		height = maxY - 4
+	}
+	left := (maxX - width) / 2
+	top := (maxY - height) / 2
+
+	tv, err := g.SetView("task_picker", left, top, left+width, top+height)
+	if err != nil && err != gocui.ErrUnknownView {
+		return err
+	}
+	tv.Title = "Tasks"
+	tv.Highlight = true
+	tv.SelBgColor = gocui.ColorGreen
+	tv.SelFgColor = gocui.ColorBlack
+	tv.Clear()
+
+	for _, task := range taskManager.Tasks() {
+		status := "running"
+		if task.Done() {
+			status = "done"
+		}
+		fmt.Fprintf(tv, "%s  [%s]  %s\n", task.App.Name, status, task.Elapsed().Round(time.Second))
+	}
+	if err := tv.SetCursor(0, taskPickerCursor); err != nil {
+		return err
+	}
+	if _, err := g.SetCurrentView("task_picker"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// appendRunHistory records a task's outcome to its per-app history log,
+// read back by the "Recent" dashboard context.
+func appendRunHistory(appName string, runErr error) {
+	path := historyLogPath(appName)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	status := "ok"
+	if runErr != nil {
+		status = runErr.Error()
+	}
+	fmt.Fprintf(f, "%s  %s\n", time.Now().Format("2006-01-02 15:04:05"), status)
+}